@@ -0,0 +1,76 @@
+// Package behavior lets third-party code register new pet behaviors by
+// name and have them instantiated from a pet's YAML configuration
+// without the scheduler needing to know their concrete types.
+package behavior
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"neodeskpet/pet"
+)
+
+// Behavior is one thing a pet can do on a tick, such as wandering,
+// following the cursor, or sleeping. Implementations are produced by a
+// factory registered with Register.
+type Behavior interface {
+	// Act performs one step of the behavior against p. The scheduler
+	// calls it on a tick when its weighted random pick selects this
+	// behavior; at most one behavior acts per tick.
+	Act(ctx context.Context, p *pet.Pet) error
+}
+
+// Factory builds a Behavior from its config map, typically the
+// corresponding entry under a pet definition's behaviors section.
+type Factory func(cfg map[string]any) (Behavior, error)
+
+var (
+	mu        sync.RWMutex
+	factories = map[string]Factory{}
+)
+
+// Register makes a behavior factory available under name. It is
+// intended to be called from an init function by both built-in
+// behaviors and third-party plugins. Register panics if name is already
+// registered, since that indicates two behaviors fighting over the same
+// config key.
+func Register(name string, factory Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, exists := factories[name]; exists {
+		panic(fmt.Sprintf("behavior: Register called twice for %q", name))
+	}
+	factories[name] = factory
+}
+
+// New instantiates the behavior registered under name, binding cfg into
+// it first. It returns an error rather than panicking so a bad or
+// outdated pet manifest doesn't take the whole app down.
+func New(name string, cfg map[string]any) (Behavior, error) {
+	mu.RLock()
+	factory, ok := factories[name]
+	mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("behavior: no behavior registered as %q (known: %v)", name, Names())
+	}
+	b, err := factory(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("behavior: build %q: %w", name, err)
+	}
+	return b, nil
+}
+
+// Names returns every currently registered behavior name, sorted for
+// stable error messages and test output.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
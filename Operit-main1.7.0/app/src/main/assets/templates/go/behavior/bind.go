@@ -0,0 +1,119 @@
+package behavior
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// cfgTag is the struct tag behaviors use to name their config fields,
+// e.g. `cfg:"speed"`. A field without the tag is matched by its Go name
+// with the first letter lower-cased instead (StepPixels matches
+// "stepPixels"), so simple behaviors need no tags at all.
+const cfgTag = "cfg"
+
+// Bind copies values from cfg onto the exported fields of dst, which
+// must be a non-nil pointer to a struct. It walks embedded structs so a
+// behavior can share a common config block, and reports an error (rather
+// than panicking) for unknown keys or values that don't convert to the
+// destination field's type, so a typo in a pet's YAML surfaces as a
+// readable error instead of a crash.
+func Bind(cfg map[string]any, dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("behavior: Bind dst must be a non-nil pointer to a struct, got %T", dst)
+	}
+
+	matched := make(map[string]bool, len(cfg))
+	if err := bindStruct(cfg, v.Elem(), matched); err != nil {
+		return err
+	}
+
+	for key := range cfg {
+		if !matched[key] {
+			return fmt.Errorf("behavior: unknown config key %q for %T", key, dst)
+		}
+	}
+	return nil
+}
+
+// bindStruct assigns matching cfg entries onto struct's fields,
+// recursing into embedded structs, and records in matched which cfg
+// keys were consumed.
+func bindStruct(cfg map[string]any, structVal reflect.Value, matched map[string]bool) error {
+	t := structVal.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldVal := structVal.Field(i)
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			if err := bindStruct(cfg, fieldVal, matched); err != nil {
+				return err
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		key := field.Tag.Get(cfgTag)
+		if key == "" {
+			key = lowerFirst(field.Name)
+		}
+		raw, ok := cfg[key]
+		if !ok {
+			continue
+		}
+		matched[key] = true
+
+		if err := assign(fieldVal, raw); err != nil {
+			return fmt.Errorf("behavior: config key %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// assign sets fieldVal to raw, converting numeric types where the
+// conversion is value-preserving (e.g. a JSON/YAML float64 into an int
+// field) and rejecting anything else as a type mismatch.
+func assign(fieldVal reflect.Value, raw any) error {
+	rawVal := reflect.ValueOf(raw)
+	if !rawVal.IsValid() {
+		return fmt.Errorf("value is nil, want %s", fieldVal.Type())
+	}
+
+	if rawVal.Type().AssignableTo(fieldVal.Type()) {
+		fieldVal.Set(rawVal)
+		return nil
+	}
+	if rawVal.Type().ConvertibleTo(fieldVal.Type()) && isNumericKind(rawVal.Kind()) && isNumericKind(fieldVal.Kind()) {
+		converted := rawVal.Convert(fieldVal.Type())
+		if converted.Convert(rawVal.Type()).Interface() != raw {
+			return fmt.Errorf("value %v (%T) does not fit in %s without loss", raw, raw, fieldVal.Type())
+		}
+		fieldVal.Set(converted)
+		return nil
+	}
+	return fmt.Errorf("value %v (%T) is not assignable to %s", raw, raw, fieldVal.Type())
+}
+
+func isNumericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	if r[0] >= 'A' && r[0] <= 'Z' {
+		r[0] += 'a' - 'A'
+	}
+	return string(r)
+}
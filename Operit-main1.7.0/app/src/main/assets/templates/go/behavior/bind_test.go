@@ -0,0 +1,100 @@
+package behavior
+
+import (
+	"strings"
+	"testing"
+)
+
+type baseConfig struct {
+	Enabled bool `cfg:"enabled"`
+}
+
+type taggedConfig struct {
+	baseConfig
+	StepPixels int    `cfg:"step_pixels"`
+	Label      string // untagged: matched by field name with its first letter lower-cased
+}
+
+func TestBindSetsTaggedAndUntaggedFields(t *testing.T) {
+	cfg := map[string]any{
+		"enabled":     true,
+		"step_pixels": 8,
+		"label":       "shiba",
+	}
+	var c taggedConfig
+	if err := Bind(cfg, &c); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+	if !c.Enabled {
+		t.Error("Enabled = false, want true")
+	}
+	if c.StepPixels != 8 {
+		t.Errorf("StepPixels = %d, want 8", c.StepPixels)
+	}
+	if c.Label != "shiba" {
+		t.Errorf("Label = %q, want %q", c.Label, "shiba")
+	}
+}
+
+func TestBindConvertsNumericTypes(t *testing.T) {
+	// YAML/JSON decoders commonly hand back float64 for any bare
+	// number; Bind must convert that into an int field.
+	cfg := map[string]any{"step_pixels": float64(12)}
+	var c taggedConfig
+	if err := Bind(cfg, &c); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+	if c.StepPixels != 12 {
+		t.Errorf("StepPixels = %d, want 12", c.StepPixels)
+	}
+}
+
+func TestBindRejectsUnknownKey(t *testing.T) {
+	cfg := map[string]any{"not_a_real_field": 1}
+	var c taggedConfig
+	err := Bind(cfg, &c)
+	if err == nil {
+		t.Fatal("Bind() error = nil, want error for unknown key")
+	}
+	if !strings.Contains(err.Error(), "not_a_real_field") {
+		t.Errorf("Bind() error = %v, want it to name the unknown key", err)
+	}
+}
+
+func TestBindRejectsTypeMismatch(t *testing.T) {
+	cfg := map[string]any{"step_pixels": "fast"}
+	var c taggedConfig
+	if err := Bind(cfg, &c); err == nil {
+		t.Fatal("Bind() error = nil, want error for type mismatch")
+	}
+}
+
+func TestBindRequiresPointerToStruct(t *testing.T) {
+	var c taggedConfig
+	if err := Bind(map[string]any{}, c); err == nil {
+		t.Fatal("Bind() error = nil, want error for non-pointer dst")
+	}
+	if err := Bind(map[string]any{}, &struct{}{}); err != nil {
+		t.Errorf("Bind() error = %v, want nil for empty struct with empty cfg", err)
+	}
+}
+
+func TestWanderBehaviorDefaultsWhenUnconfigured(t *testing.T) {
+	b, err := New("wander", nil)
+	if err != nil {
+		t.Fatalf("New(wander) error = %v", err)
+	}
+	w, ok := b.(*WanderBehavior)
+	if !ok {
+		t.Fatalf("New(wander) returned %T, want *WanderBehavior", b)
+	}
+	if w.StepPixels != 8 {
+		t.Errorf("StepPixels = %d, want the built-in default 8", w.StepPixels)
+	}
+}
+
+func TestNewUnknownBehavior(t *testing.T) {
+	if _, err := New("does_not_exist", nil); err == nil {
+		t.Fatal("New() error = nil, want error for unregistered behavior")
+	}
+}
@@ -0,0 +1,68 @@
+package behavior
+
+import (
+	"context"
+	"sync/atomic"
+
+	"neodeskpet/pet"
+)
+
+func init() {
+	Register("follow_cursor", func(cfg map[string]any) (Behavior, error) {
+		b := &FollowCursorBehavior{Speed: 4}
+		if err := Bind(cfg, b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	})
+}
+
+// FollowCursorBehavior steps the pet towards the last cursor position
+// reported via SetCursor, at most Speed pixels per axis per tick. It does
+// not subscribe to mouse events itself: the cursor position changes only
+// when SetCursor is called, which may happen on a different goroutine
+// than Act runs on.
+type FollowCursorBehavior struct {
+	// Speed bounds how far the pet can close the gap to the cursor in a
+	// single tick, in pixels per axis.
+	Speed int `cfg:"speed"`
+
+	cursor atomic.Value // pet.Position
+}
+
+// SetCursor records the latest known cursor position for Act to chase.
+func (f *FollowCursorBehavior) SetCursor(pos pet.Position) {
+	f.cursor.Store(pos)
+}
+
+// Act moves p one step closer to the last position reported to
+// SetCursor. If SetCursor has never been called, Act is a no-op.
+func (f *FollowCursorBehavior) Act(ctx context.Context, p *pet.Pet) error {
+	stored := f.cursor.Load()
+	if stored == nil {
+		return nil
+	}
+	target := stored.(pet.Position)
+	speed := f.Speed
+	if speed <= 0 {
+		speed = 1
+	}
+
+	p.SetState(pet.StateWalking)
+	p.MoveTo(pet.Position{
+		X: p.Pos.X + clamp(target.X-p.Pos.X, -speed, speed),
+		Y: p.Pos.Y + clamp(target.Y-p.Pos.Y, -speed, speed),
+	})
+	return nil
+}
+
+func clamp(v, lo, hi int) int {
+	switch {
+	case v < lo:
+		return lo
+	case v > hi:
+		return hi
+	default:
+		return v
+	}
+}
@@ -0,0 +1,33 @@
+package behavior
+
+import (
+	"context"
+
+	"neodeskpet/pet"
+)
+
+func init() {
+	Register("sleep", func(cfg map[string]any) (Behavior, error) {
+		b := &SleepBehavior{MoodThreshold: 20}
+		if err := Bind(cfg, b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	})
+}
+
+// SleepBehavior puts the pet to sleep once its mood drops to
+// MoodThreshold or below, the way a real pet naps when it's tired out.
+type SleepBehavior struct {
+	// MoodThreshold is the mood at or below which the pet falls asleep.
+	MoodThreshold int `cfg:"mood_threshold"`
+}
+
+// Act transitions p to StateSleeping when its mood is low enough, and
+// leaves it alone otherwise so other behaviors can run.
+func (s *SleepBehavior) Act(ctx context.Context, p *pet.Pet) error {
+	if int(p.Mood()) <= s.MoodThreshold {
+		p.SetState(pet.StateSleeping)
+	}
+	return nil
+}
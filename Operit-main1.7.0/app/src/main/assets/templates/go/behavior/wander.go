@@ -0,0 +1,41 @@
+package behavior
+
+import (
+	"context"
+	"math/rand"
+
+	"neodeskpet/pet"
+)
+
+func init() {
+	Register("wander", func(cfg map[string]any) (Behavior, error) {
+		b := &WanderBehavior{StepPixels: 8}
+		if err := Bind(cfg, b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	})
+}
+
+// WanderBehavior moves the pet a small random distance each tick and
+// leaves it in StateWalking, the way an idle pet ambles around the
+// desktop on its own.
+type WanderBehavior struct {
+	// StepPixels bounds how far the pet can move in a single tick.
+	StepPixels int `cfg:"step_pixels"`
+}
+
+// Act nudges p.Pos by a random offset in [-StepPixels, StepPixels] on
+// both axes.
+func (w *WanderBehavior) Act(ctx context.Context, p *pet.Pet) error {
+	step := w.StepPixels
+	if step <= 0 {
+		step = 1
+	}
+	p.SetState(pet.StateWalking)
+	p.MoveTo(pet.Position{
+		X: p.Pos.X + rand.Intn(2*step+1) - step,
+		Y: p.Pos.Y + rand.Intn(2*step+1) - step,
+	})
+	return nil
+}
@@ -0,0 +1,113 @@
+// Package cli is a headless REPL that drives a pet session from stdin,
+// alongside (or instead of) the GUI. It gives power users a way to
+// script the pet and gives CI a way to exercise the app without a
+// display.
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"neodeskpet/pet/scheduler"
+)
+
+// Session runs commands against a pet's scheduler, writing results to
+// Out. Session never touches the underlying *pet.Pet directly — it goes
+// through Scheduler's synchronized accessors, since the scheduler's own
+// goroutine is mutating the pet concurrently.
+type Session struct {
+	Scheduler *scheduler.Scheduler
+	Out       io.Writer
+}
+
+// NewSession returns a Session that drives sched's pet, printing command
+// results to out.
+func NewSession(sched *scheduler.Scheduler, out io.Writer) *Session {
+	return &Session{Scheduler: sched, Out: out}
+}
+
+// Run reads commands from in, one per line, until in is exhausted, ctx
+// is cancelled, or a "quit" command is read. Each command's result (or
+// error) is printed to s.Out as it runs.
+func (s *Session) Run(ctx context.Context, in io.Reader) error {
+	reader := bufio.NewReader(in)
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		line, err := reader.ReadString('\n')
+		if line = strings.TrimSpace(line); line != "" {
+			if quit := s.dispatch(line); quit {
+				return nil
+			}
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("cli: read command: %w", err)
+		}
+	}
+}
+
+// dispatch parses and runs a single command line, reporting its result
+// to s.Out. It returns true if the command was "quit".
+func (s *Session) dispatch(line string) (quit bool) {
+	fields := strings.Fields(line)
+	cmd, args := fields[0], fields[1:]
+
+	switch cmd {
+	case "feed":
+		snap := s.Scheduler.Feed(10)
+		fmt.Fprintf(s.Out, "fed %s, mood is now %d\n", snap.Species, snap.Mood)
+
+	case "pet":
+		snap := s.Scheduler.Feed(5)
+		fmt.Fprintf(s.Out, "petted %s, mood is now %d\n", snap.Species, snap.Mood)
+
+	case "move":
+		x, y, err := parseXY(args)
+		if err != nil {
+			fmt.Fprintf(s.Out, "error: %v\n", err)
+			return false
+		}
+		s.Scheduler.Clicks <- scheduler.ClickEvent{X: x, Y: y}
+		fmt.Fprintf(s.Out, "moving %s to (%d, %d)\n", s.Scheduler.Snapshot().Species, x, y)
+
+	case "say":
+		msg := strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, cmd)), `"`)
+		fmt.Fprintf(s.Out, "%s: %s\n", s.Scheduler.Snapshot().Species, msg)
+
+	case "stats":
+		fmt.Fprintln(s.Out, s.Scheduler.Snapshot())
+
+	case "quit":
+		fmt.Fprintln(s.Out, "bye")
+		return true
+
+	default:
+		fmt.Fprintf(s.Out, "error: unknown command %q\n", cmd)
+	}
+	return false
+}
+
+// parseXY parses args as exactly two decimal integers.
+func parseXY(args []string) (x, y int, err error) {
+	if len(args) != 2 {
+		return 0, 0, fmt.Errorf("move requires exactly 2 arguments, got %d", len(args))
+	}
+	xi, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid x %q: %w", args[0], err)
+	}
+	yi, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid y %q: %w", args[1], err)
+	}
+	return int(xi), int(yi), nil
+}
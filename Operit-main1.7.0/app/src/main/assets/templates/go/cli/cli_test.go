@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"neodeskpet/pet"
+	"neodeskpet/pet/scheduler"
+)
+
+func newTestSession(t *testing.T) (*Session, *bytes.Buffer, context.CancelFunc) {
+	t.Helper()
+	p := pet.New("shiba", pet.Position{})
+	sched := scheduler.New(p, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	go sched.Run(ctx)
+
+	var out bytes.Buffer
+	return NewSession(sched, &out), &out, cancel
+}
+
+func TestRunProcessesCommandsUntilQuit(t *testing.T) {
+	s, out, cancel := newTestSession(t)
+	defer cancel()
+
+	in := strings.NewReader("feed\nstats\nquit\nshould not run\n")
+	if err := s.Run(context.Background(), in); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	got := out.String()
+	if !strings.Contains(got, "fed shiba, mood is now 60") {
+		t.Errorf("output = %q, want it to report the fed mood", got)
+	}
+	if !strings.Contains(got, "bye") {
+		t.Errorf("output = %q, want a goodbye on quit", got)
+	}
+	if strings.Contains(got, "should not run") {
+		t.Errorf("output = %q, commands after quit should not run", got)
+	}
+}
+
+func TestRunStopsAtEOFWithoutQuit(t *testing.T) {
+	s, out, cancel := newTestSession(t)
+	defer cancel()
+
+	in := strings.NewReader("stats\n")
+	if err := s.Run(context.Background(), in); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "shiba") {
+		t.Errorf("output = %q, want pet stats", out.String())
+	}
+}
+
+func TestMoveDispatchesThroughScheduler(t *testing.T) {
+	s, _, cancel := newTestSession(t)
+	defer cancel()
+
+	go s.dispatch("move 10 20")
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if s.Scheduler.Snapshot().Pos == (pet.Position{X: 10, Y: 20}) {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("pet position was never updated by the scheduler")
+}
+
+func TestMoveRejectsBadArgs(t *testing.T) {
+	s, out, cancel := newTestSession(t)
+	defer cancel()
+
+	s.dispatch("move 10")
+	if !strings.Contains(out.String(), "error:") {
+		t.Errorf("output = %q, want an error for wrong arg count", out.String())
+	}
+}
+
+func TestSayEchoesMessage(t *testing.T) {
+	s, out, cancel := newTestSession(t)
+	defer cancel()
+
+	s.dispatch(`say "hello there"`)
+	if !strings.Contains(out.String(), "shiba: hello there") {
+		t.Errorf("output = %q, want the say message echoed", out.String())
+	}
+}
+
+func TestUnknownCommand(t *testing.T) {
+	s, out, cancel := newTestSession(t)
+	defer cancel()
+
+	s.dispatch("fly")
+	if !strings.Contains(out.String(), "unknown command") {
+		t.Errorf("output = %q, want an unknown command error", out.String())
+	}
+}
@@ -0,0 +1,126 @@
+// Command neodeskpet is the entrypoint for the NeoDeskPet desktop
+// companion. It only wires together the pet, scheduler, render, input,
+// config and cli packages; it contains no behavior of its own.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"neodeskpet/cli"
+	"neodeskpet/config"
+	"neodeskpet/input"
+	"neodeskpet/pet"
+	"neodeskpet/pet/scheduler"
+	"neodeskpet/render"
+)
+
+// effectWorkers bounds how many goroutines may run particle/emote
+// effects concurrently.
+const effectWorkers = 8
+
+// petManifestDir is where pet YAML manifests (see pets/shiba.yaml) are
+// looked up by species name.
+const petManifestDir = "pets"
+
+func main() {
+	cliMode := flag.Bool("cli", false, "drive the pet from an interactive stdin REPL instead of the GUI")
+	flag.Parse()
+
+	fmt.Println("🚀 欢迎来到 NeoDeskPet！")
+
+	cfg := config.Default()
+	p := pet.New(cfg.Species, pet.Position{X: cfg.WindowWidth / 2, Y: cfg.WindowHeight / 2})
+	sched := scheduler.New(p, effectWorkers)
+
+	watcher, err := loadPetManifest(sched, cfg.Species)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "pet manifest: %v\n", err)
+	} else {
+		defer watcher.Close()
+	}
+
+	surface := newSurface(cfg.WindowWidth, cfg.WindowHeight, *cliMode)
+	defer surface.Close()
+
+	dispatcher := input.NewDispatcher()
+	input.Bind(dispatcher, surface)
+	dispatcher.OnMouse(func(ev render.MouseEvent) {
+		if ev.Kind == render.MouseDown {
+			sched.Clicks <- scheduler.ClickEvent{X: ev.X, Y: ev.Y}
+		}
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go runTicker(ctx, sched, cfg.TickRate)
+
+	fmt.Printf("✅ %s 已就绪：%s\n", cfg.Species, p)
+
+	schedDone := make(chan error, 1)
+	go func() { schedDone <- sched.Run(ctx) }()
+
+	if *cliMode {
+		session := cli.NewSession(sched, os.Stdout)
+		if err := session.Run(ctx, os.Stdin); err != nil {
+			fmt.Fprintf(os.Stderr, "cli stopped: %v\n", err)
+		}
+		stop()
+	}
+
+	if err := <-schedDone; err != nil && err != context.Canceled {
+		fmt.Fprintf(os.Stderr, "scheduler stopped: %v\n", err)
+	}
+}
+
+// loadPetManifest loads petManifestDir/<species>.yaml, seeds sched's
+// behaviors from it, and watches the file for edits so changing the
+// manifest updates the running pet without a restart. A missing or
+// invalid manifest is reported to the caller rather than treated as
+// fatal, since the pet can still run with no configured behaviors.
+func loadPetManifest(sched *scheduler.Scheduler, species string) (*config.PetWatcher, error) {
+	path := filepath.Join(petManifestDir, species+".yaml")
+
+	def, err := config.LoadPet(path)
+	if err != nil {
+		return nil, fmt.Errorf("load %s: %w", path, err)
+	}
+	if err := sched.LoadBehaviors(def); err != nil {
+		return nil, fmt.Errorf("load behaviors from %s: %w", path, err)
+	}
+
+	return config.WatchPet(path, func(def *pet.Definition, err error) {
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "reloading %s: %v\n", path, err)
+			return
+		}
+		if err := sched.LoadBehaviors(def); err != nil {
+			fmt.Fprintf(os.Stderr, "reloading behaviors from %s: %v\n", path, err)
+		}
+	})
+}
+
+// runTicker sends a TickEvent rate times per second until ctx is done.
+func runTicker(ctx context.Context, sched *scheduler.Scheduler, rate int) {
+	if rate < 1 {
+		rate = 1
+	}
+	ticker := time.NewTicker(time.Second / time.Duration(rate))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sched.Ticks <- scheduler.TickEvent{}
+		}
+	}
+}
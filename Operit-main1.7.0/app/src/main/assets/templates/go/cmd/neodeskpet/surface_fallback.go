@@ -0,0 +1,12 @@
+//go:build !((linux || darwin || windows) && cgo)
+
+package main
+
+import "neodeskpet/render"
+
+// newSurface returns a NullSurface: either CGo is disabled or this is an
+// OS render/native has no backend for, so there's no native window
+// implementation to open.
+func newSurface(width, height int, cliMode bool) render.Surface {
+	return render.NewNullSurface()
+}
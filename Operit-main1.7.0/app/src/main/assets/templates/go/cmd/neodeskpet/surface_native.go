@@ -0,0 +1,28 @@
+//go:build (linux || darwin || windows) && cgo
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"neodeskpet/render"
+	"neodeskpet/render/native"
+)
+
+// newSurface opens the platform's CGo-backed always-on-top window. In
+// -cli mode there's nothing to show it on, so it falls back to a
+// NullSurface; it also falls back if the native backend fails to open a
+// window (e.g. no display available), logging why instead of exiting.
+func newSurface(width, height int, cliMode bool) render.Surface {
+	if cliMode {
+		return render.NewNullSurface()
+	}
+
+	surface, err := native.New(width, height, native.DefaultTitle)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "native window unavailable, falling back to headless: %v\n", err)
+		return render.NewNullSurface()
+	}
+	return surface
+}
@@ -0,0 +1,26 @@
+// Package config holds user-facing settings for the pet app, such as
+// which species to load and how fast it ticks.
+package config
+
+// Settings are the user-configurable knobs for a running pet session.
+type Settings struct {
+	// Species is the name of the pet definition to load, e.g. "shiba".
+	Species string
+
+	// TickRate is how many behavior ticks happen per second.
+	TickRate int
+
+	// WindowWidth and WindowHeight size the render surface, in pixels.
+	WindowWidth  int
+	WindowHeight int
+}
+
+// Default returns the settings a fresh install starts with.
+func Default() Settings {
+	return Settings{
+		Species:      "shiba",
+		TickRate:     30,
+		WindowWidth:  256,
+		WindowHeight: 256,
+	}
+}
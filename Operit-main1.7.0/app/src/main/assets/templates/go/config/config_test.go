@@ -0,0 +1,17 @@
+package config
+
+import "testing"
+
+func TestDefault(t *testing.T) {
+	s := Default()
+
+	if s.Species == "" {
+		t.Error("Species should not be empty")
+	}
+	if s.TickRate <= 0 {
+		t.Errorf("TickRate = %d, want > 0", s.TickRate)
+	}
+	if s.WindowWidth <= 0 || s.WindowHeight <= 0 {
+		t.Errorf("WindowWidth/WindowHeight = %d/%d, want > 0", s.WindowWidth, s.WindowHeight)
+	}
+}
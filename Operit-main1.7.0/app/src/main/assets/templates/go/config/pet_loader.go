@@ -0,0 +1,97 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+	"gopkg.in/yaml.v3"
+
+	"neodeskpet/pet"
+)
+
+// LoadPet reads, parses and validates a pet definition manifest (see
+// pets/shiba.yaml for an example).
+func LoadPet(path string) (*pet.Definition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config: read pet definition: %w", err)
+	}
+
+	var def pet.Definition
+	if err := yaml.Unmarshal(data, &def); err != nil {
+		return nil, fmt.Errorf("config: parse pet definition %s: %w", path, err)
+	}
+	if err := def.Validate(); err != nil {
+		return nil, fmt.Errorf("config: invalid pet definition %s: %w", path, err)
+	}
+	return &def, nil
+}
+
+// PetWatcher reloads a pet definition whenever its manifest file changes
+// on disk, so editing a YAML file updates the running pet.
+type PetWatcher struct {
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+}
+
+// WatchPet loads path once to fail fast on an unusable manifest, then
+// watches it for changes. Every subsequent write calls onChange with the
+// freshly reloaded definition; a bad edit is reported as an error to
+// onChange rather than killing the watch, so a typo doesn't take the pet
+// down. Callers must call Close on the returned watcher when done.
+func WatchPet(path string, onChange func(*pet.Definition, error)) (*PetWatcher, error) {
+	if _, err := LoadPet(path); err != nil {
+		return nil, err
+	}
+
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("config: create watcher: %w", err)
+	}
+	// Watch the containing directory rather than the file itself: many
+	// editors replace a file on save (write a temp file, rename over the
+	// original), which drops an fsnotify watch on the original inode.
+	if err := w.Add(filepath.Dir(path)); err != nil {
+		w.Close()
+		return nil, fmt.Errorf("config: watch %s: %w", path, err)
+	}
+
+	pw := &PetWatcher{watcher: w, done: make(chan struct{})}
+	go pw.loop(path, onChange)
+	return pw, nil
+}
+
+func (pw *PetWatcher) loop(path string, onChange func(*pet.Definition, error)) {
+	target := filepath.Clean(path)
+	for {
+		select {
+		case <-pw.done:
+			return
+		case ev, ok := <-pw.watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(ev.Name) != target {
+				continue
+			}
+			if ev.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			def, err := LoadPet(path)
+			onChange(def, err)
+		case err, ok := <-pw.watcher.Errors:
+			if !ok {
+				return
+			}
+			onChange(nil, err)
+		}
+	}
+}
+
+// Close stops watching path and releases the underlying OS resources.
+func (pw *PetWatcher) Close() error {
+	close(pw.done)
+	return pw.watcher.Close()
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"neodeskpet/pet"
+)
+
+const shibaYAML = `
+name: shiba
+sprite:
+  path: assets/sprites/shiba.png
+  frames:
+    idle:
+      - index: 0
+        duration_ms: 400
+behaviors:
+  wander:
+    weight: 0.5
+    cfg:
+      step_pixels: 6
+`
+
+func TestLoadPetRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "shiba.yaml")
+	if err := os.WriteFile(path, []byte(shibaYAML), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	def, err := LoadPet(path)
+	if err != nil {
+		t.Fatalf("LoadPet() error = %v", err)
+	}
+	if def.Name != "shiba" {
+		t.Errorf("Name = %q, want %q", def.Name, "shiba")
+	}
+	if def.Sprite.Path != "assets/sprites/shiba.png" {
+		t.Errorf("Sprite.Path = %q, want %q", def.Sprite.Path, "assets/sprites/shiba.png")
+	}
+	wander := def.Behaviors["wander"]
+	if wander.Weight != 0.5 {
+		t.Errorf("Behaviors[wander].Weight = %v, want %v", wander.Weight, 0.5)
+	}
+	if got, want := wander.Cfg["step_pixels"], 6; got != want {
+		t.Errorf("Behaviors[wander].Cfg[step_pixels] = %v, want %v", got, want)
+	}
+}
+
+func TestLoadPetInvalid(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "broken.yaml")
+	if err := os.WriteFile(path, []byte("name: broken\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	if _, err := LoadPet(path); err == nil {
+		t.Fatal("LoadPet() error = nil, want error for missing sprite.path")
+	}
+}
+
+func TestWatchPetReloadsOnWrite(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "shiba.yaml")
+	if err := os.WriteFile(path, []byte(shibaYAML), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	reloaded := make(chan *pet.Definition, 1)
+	w, err := WatchPet(path, func(def *pet.Definition, err error) {
+		if err == nil {
+			reloaded <- def
+		}
+	})
+	if err != nil {
+		t.Fatalf("WatchPet() error = %v", err)
+	}
+	defer w.Close()
+
+	updated := shibaYAML + "sounds:\n  - assets/sounds/bark.wav\n"
+	if err := os.WriteFile(path, []byte(updated), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	select {
+	case def := <-reloaded:
+		if len(def.Sounds) != 1 {
+			t.Errorf("Sounds = %v, want 1 entry", def.Sounds)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for reload")
+	}
+}
+
+func TestWatchPetFailsFastOnBadManifest(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "broken.yaml")
+	if err := os.WriteFile(path, []byte("name: broken\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	if _, err := WatchPet(path, func(def *pet.Definition, err error) {}); err == nil {
+		t.Fatal("WatchPet() error = nil, want error for invalid manifest")
+	}
+}
@@ -0,0 +1,59 @@
+// Package input turns raw mouse and keyboard events from a render.Surface
+// (or any other source) into the typed events the rest of the pet
+// understands, via a small pub/sub Dispatcher.
+package input
+
+import "neodeskpet/render"
+
+// KeyEvent describes a single key press or release.
+type KeyEvent struct {
+	Key     string
+	Pressed bool
+}
+
+// Dispatcher fans a single stream of input events out to any number of
+// subscribers. It has no concurrency control of its own; callers that
+// need ordering or backpressure should hand events to it from a single
+// goroutine (see pet/scheduler, which owns the event loop).
+type Dispatcher struct {
+	mouseSubs []func(render.MouseEvent)
+	keySubs   []func(KeyEvent)
+}
+
+// NewDispatcher returns an empty Dispatcher.
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{}
+}
+
+// OnMouse registers fn to be called for every dispatched mouse event.
+func (d *Dispatcher) OnMouse(fn func(render.MouseEvent)) {
+	d.mouseSubs = append(d.mouseSubs, fn)
+}
+
+// OnKey registers fn to be called for every dispatched key event.
+func (d *Dispatcher) OnKey(fn func(KeyEvent)) {
+	d.keySubs = append(d.keySubs, fn)
+}
+
+// DispatchMouse delivers a mouse event to all subscribers, in
+// registration order.
+func (d *Dispatcher) DispatchMouse(ev render.MouseEvent) {
+	for _, fn := range d.mouseSubs {
+		fn(ev)
+	}
+}
+
+// DispatchKey delivers a key event to all subscribers, in registration
+// order.
+func (d *Dispatcher) DispatchKey(ev KeyEvent) {
+	for _, fn := range d.keySubs {
+		fn(ev)
+	}
+}
+
+// Bind wires a Dispatcher up to a render.Surface so that every mouse
+// event the surface reports is fanned out to the dispatcher's
+// subscribers.
+func Bind(d *Dispatcher, surface render.Surface) {
+	surface.OnMouseEvent(d.DispatchMouse)
+}
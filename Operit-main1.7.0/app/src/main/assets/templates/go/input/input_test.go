@@ -0,0 +1,61 @@
+package input
+
+import (
+	"testing"
+
+	"neodeskpet/render"
+)
+
+func TestDispatchMouse(t *testing.T) {
+	d := NewDispatcher()
+
+	var got []render.MouseEvent
+	d.OnMouse(func(ev render.MouseEvent) {
+		got = append(got, ev)
+	})
+
+	d.DispatchMouse(render.MouseEvent{X: 1, Y: 2, Kind: render.MouseMove})
+	d.DispatchMouse(render.MouseEvent{X: 3, Y: 4, Kind: render.MouseDown})
+
+	if len(got) != 2 {
+		t.Fatalf("got %d events, want 2", len(got))
+	}
+	if got[1].Kind != render.MouseDown {
+		t.Errorf("got[1].Kind = %v, want MouseDown", got[1].Kind)
+	}
+}
+
+func TestDispatchKey(t *testing.T) {
+	d := NewDispatcher()
+
+	var got KeyEvent
+	called := false
+	d.OnKey(func(ev KeyEvent) {
+		called = true
+		got = ev
+	})
+
+	d.DispatchKey(KeyEvent{Key: "f", Pressed: true})
+
+	if !called {
+		t.Fatal("OnKey callback was not invoked")
+	}
+	if got.Key != "f" || !got.Pressed {
+		t.Errorf("got %+v, want {f true}", got)
+	}
+}
+
+func TestBindForwardsSurfaceEvents(t *testing.T) {
+	surface := render.NewNullSurface()
+	d := NewDispatcher()
+	Bind(d, surface)
+
+	var got render.MouseEvent
+	d.OnMouse(func(ev render.MouseEvent) { got = ev })
+
+	surface.Emit(render.MouseEvent{X: 7, Y: 8, Kind: render.MouseUp})
+
+	if got.X != 7 || got.Y != 8 {
+		t.Errorf("got %+v, want X=7 Y=8", got)
+	}
+}
@@ -0,0 +1,67 @@
+package pet
+
+import "fmt"
+
+// Definition is the on-disk description of a pet species: where its
+// sprite atlas lives, how each animation state is timed, what it can
+// say, and which named behaviors it runs and how they're configured. It
+// is loaded from a YAML manifest by config.LoadPet.
+type Definition struct {
+	Name      string                    `yaml:"name"`
+	Sprite    SpriteAtlas               `yaml:"sprite"`
+	Sounds    []string                  `yaml:"sounds"`
+	Dialogue  []string                  `yaml:"dialogue"`
+	Behaviors map[string]BehaviorConfig `yaml:"behaviors"`
+}
+
+// BehaviorConfig configures one behavior a pet manifest names under
+// behaviors: how likely the scheduler is to pick it on a given tick
+// (Weight), and the per-behavior settings (e.g. step_pixels, speed) it
+// binds onto that behavior's config struct via the behavior package's
+// reflection binder.
+type BehaviorConfig struct {
+	Weight float64        `yaml:"weight"`
+	Cfg    map[string]any `yaml:"cfg"`
+}
+
+// SpriteAtlas locates the image containing a pet's frames and describes
+// how those frames are timed per animation state.
+type SpriteAtlas struct {
+	Path   string            `yaml:"path"`
+	Frames map[State][]Frame `yaml:"frames"`
+}
+
+// Frame is one entry in an animation's timeline: which frame index of
+// the atlas to show, and for how long.
+type Frame struct {
+	Index      int `yaml:"index"`
+	DurationMS int `yaml:"duration_ms"`
+}
+
+// Validate checks that a Definition is well-formed enough to run: it has
+// a name, an atlas path, at least one animation frame per declared
+// state, positive frame durations, and non-negative behavior weights.
+func (d *Definition) Validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("pet: definition is missing a name")
+	}
+	if d.Sprite.Path == "" {
+		return fmt.Errorf("pet: %s: sprite.path is required", d.Name)
+	}
+	for state, frames := range d.Sprite.Frames {
+		if len(frames) == 0 {
+			return fmt.Errorf("pet: %s: animation %q has no frames", d.Name, state)
+		}
+		for i, f := range frames {
+			if f.DurationMS <= 0 {
+				return fmt.Errorf("pet: %s: animation %q frame %d has non-positive duration_ms", d.Name, state, i)
+			}
+		}
+	}
+	for name, bc := range d.Behaviors {
+		if bc.Weight < 0 {
+			return fmt.Errorf("pet: %s: behavior %q has negative weight %v", d.Name, name, bc.Weight)
+		}
+	}
+	return nil
+}
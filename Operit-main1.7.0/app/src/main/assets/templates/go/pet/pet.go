@@ -0,0 +1,101 @@
+// Package pet implements the desktop pet's sprite state machine: its
+// position on screen, its current animation state, and its mood.
+package pet
+
+import "fmt"
+
+// State is an animation/behavior state the pet can be in.
+type State string
+
+const (
+	StateIdle     State = "idle"
+	StateWalking  State = "walking"
+	StateDragging State = "dragging"
+	StateSleeping State = "sleeping"
+)
+
+// Mood represents how content the pet currently is. It ranges from 0
+// (miserable) to 100 (delighted) and decays over time unless the pet is
+// fed or played with.
+type Mood int
+
+const (
+	MoodMin Mood = 0
+	MoodMax Mood = 100
+)
+
+// Clamp restricts m to the [MoodMin, MoodMax] range.
+func (m Mood) Clamp() Mood {
+	switch {
+	case m < MoodMin:
+		return MoodMin
+	case m > MoodMax:
+		return MoodMax
+	default:
+		return m
+	}
+}
+
+// Position is the pet's location on the virtual desktop, in pixels.
+type Position struct {
+	X, Y int
+}
+
+// Pet is a single desktop companion: its species, where it is, what it's
+// doing, and how it feels. Pet is not safe for concurrent use by itself;
+// callers that mutate it from multiple goroutines (see pet/scheduler)
+// must synchronize externally.
+type Pet struct {
+	Species string
+	Pos     Position
+	state   State
+	mood    Mood
+}
+
+// New creates a pet of the given species, starting idle at pos with a
+// neutral mood.
+func New(species string, pos Position) *Pet {
+	return &Pet{
+		Species: species,
+		Pos:     pos,
+		state:   StateIdle,
+		mood:    50,
+	}
+}
+
+// State returns the pet's current animation state.
+func (p *Pet) State() State {
+	return p.state
+}
+
+// SetState transitions the pet to a new animation state.
+func (p *Pet) SetState(s State) {
+	p.state = s
+}
+
+// Mood returns the pet's current mood.
+func (p *Pet) Mood() Mood {
+	return p.mood
+}
+
+// Feed raises the pet's mood, as if it had just been fed.
+func (p *Pet) Feed(amount Mood) {
+	p.mood = (p.mood + amount).Clamp()
+}
+
+// Decay lowers the pet's mood by amount, never going below MoodMin. It is
+// intended to be called periodically by a timer.
+func (p *Pet) Decay(amount Mood) {
+	p.mood = (p.mood - amount).Clamp()
+}
+
+// MoveTo updates the pet's position, for example in response to a drag
+// or a wander/follow behavior.
+func (p *Pet) MoveTo(pos Position) {
+	p.Pos = pos
+}
+
+// String implements fmt.Stringer for debug logging.
+func (p *Pet) String() string {
+	return fmt.Sprintf("%s@(%d,%d) state=%s mood=%d", p.Species, p.Pos.X, p.Pos.Y, p.state, p.mood)
+}
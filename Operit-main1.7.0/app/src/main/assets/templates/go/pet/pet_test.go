@@ -0,0 +1,45 @@
+package pet
+
+import "testing"
+
+func TestNewDefaults(t *testing.T) {
+	p := New("shiba", Position{X: 10, Y: 20})
+
+	if p.State() != StateIdle {
+		t.Errorf("State() = %q, want %q", p.State(), StateIdle)
+	}
+	if p.Mood() != 50 {
+		t.Errorf("Mood() = %d, want 50", p.Mood())
+	}
+	if p.Pos != (Position{X: 10, Y: 20}) {
+		t.Errorf("Pos = %+v, want {10 20}", p.Pos)
+	}
+}
+
+func TestMoodClamp(t *testing.T) {
+	p := New("shiba", Position{})
+
+	p.Feed(1000)
+	if p.Mood() != MoodMax {
+		t.Errorf("Mood() = %d after overfeeding, want %d", p.Mood(), MoodMax)
+	}
+
+	p.Decay(1000)
+	if p.Mood() != MoodMin {
+		t.Errorf("Mood() = %d after decay, want %d", p.Mood(), MoodMin)
+	}
+}
+
+func TestSetStateAndMove(t *testing.T) {
+	p := New("shiba", Position{})
+
+	p.SetState(StateWalking)
+	if p.State() != StateWalking {
+		t.Errorf("State() = %q, want %q", p.State(), StateWalking)
+	}
+
+	p.MoveTo(Position{X: 5, Y: 6})
+	if p.Pos != (Position{X: 5, Y: 6}) {
+		t.Errorf("Pos = %+v, want {5 6}", p.Pos)
+	}
+}
@@ -0,0 +1,257 @@
+// Package scheduler runs a pet's event loop: clicks, ticks and state
+// changes arrive on typed channels, and a single select loop in Run
+// applies each one to the pet under a mutex. A tick decays mood and runs
+// one weighted-random behavior. Event handling and behaviors run on Run's
+// own goroutine, not each on a goroutine of its own; only visual effects
+// run concurrently, on a bounded worker pool.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+
+	"neodeskpet/behavior"
+	"neodeskpet/pet"
+)
+
+// ClickEvent is sent when the user clicks the pet.
+type ClickEvent struct {
+	X, Y int
+}
+
+// TickEvent drives periodic behavior such as mood decay and idle
+// animation advancement.
+type TickEvent struct{}
+
+// StateChange requests that the pet transition to a new state.
+type StateChange struct {
+	State pet.State
+}
+
+// Snapshot is a consistent, point-in-time copy of a pet's observable
+// state. Pet itself is documented as unsafe for concurrent use, so a
+// Snapshot is the only safe way for another goroutine (cli, tests) to
+// read a pet that a Scheduler is running.
+type Snapshot struct {
+	Species string
+	Pos     pet.Position
+	State   pet.State
+	Mood    pet.Mood
+}
+
+// String renders the snapshot the same way pet.Pet.String does, for
+// debug logging.
+func (sn Snapshot) String() string {
+	return fmt.Sprintf("%s@(%d,%d) state=%s mood=%d", sn.Species, sn.Pos.X, sn.Pos.Y, sn.State, sn.Mood)
+}
+
+// defaultEffectQueue is how many pending particle/emote effects the
+// worker pool will buffer before QueueEffect starts reporting back
+// pressure; it bounds memory use regardless of how fast effects are
+// produced.
+const defaultEffectQueue = 256
+
+// Scheduler owns a pet's event loop. Zero value is not usable; construct
+// with New.
+type Scheduler struct {
+	pet *pet.Pet
+	mu  sync.Mutex
+
+	// Clicks, Ticks and Changes are the channels callers feed events
+	// into; Run selects over all three until its context is done.
+	Clicks  chan ClickEvent
+	Ticks   chan TickEvent
+	Changes chan StateChange
+
+	effects chan func()
+	workers int
+
+	// behaviors are the currently loaded weighted behaviors, set by
+	// LoadBehaviors and picked from by handleTick. Guarded by mu like
+	// every other piece of pet state.
+	behaviors []weightedBehavior
+}
+
+// weightedBehavior pairs a Behavior with the relative weight its pet
+// manifest configured it with.
+type weightedBehavior struct {
+	name   string
+	weight float64
+	behave behavior.Behavior
+}
+
+// New returns a Scheduler for p with a bounded pool of workers used to
+// run visual effects (particles, emotes) without leaking goroutines.
+func New(p *pet.Pet, workers int) *Scheduler {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Scheduler{
+		pet:     p,
+		Clicks:  make(chan ClickEvent, 16),
+		Ticks:   make(chan TickEvent, 16),
+		Changes: make(chan StateChange, 16),
+		effects: make(chan func(), defaultEffectQueue),
+		workers: workers,
+	}
+}
+
+// QueueEffect schedules fn to run on the worker pool. It does not block:
+// if the effect queue is full, the effect is dropped and QueueEffect
+// returns false.
+func (s *Scheduler) QueueEffect(fn func()) bool {
+	select {
+	case s.effects <- fn:
+		return true
+	default:
+		return false
+	}
+}
+
+// Run drives the scheduler's event loop until ctx is cancelled. It
+// starts the effect worker pool, selects over Clicks/Ticks/Changes,
+// applies them to the pet under lock, and shuts every worker down
+// before returning.
+func (s *Scheduler) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for i := 0; i < s.workers; i++ {
+		wg.Add(1)
+		go s.runEffectWorker(ctx, &wg)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		case ev := <-s.Clicks:
+			s.handleClick(ev)
+		case <-s.Ticks:
+			s.handleTick(ctx)
+		case sc := <-s.Changes:
+			s.applyState(sc.State)
+		}
+	}
+}
+
+func (s *Scheduler) runEffectWorker(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case fn := <-s.effects:
+			fn()
+		}
+	}
+}
+
+func (s *Scheduler) handleClick(ev ClickEvent) {
+	s.mu.Lock()
+	s.pet.SetState(pet.StateDragging)
+	s.pet.MoveTo(pet.Position{X: ev.X, Y: ev.Y})
+	s.mu.Unlock()
+}
+
+// handleTick decays mood and, if any behaviors are loaded, runs one of
+// them chosen at random weighted by its configured probability. The
+// behavior's Act runs under s.mu, same as every other mutation of the
+// pet, so a misbehaving Act can't race the rest of the scheduler.
+func (s *Scheduler) handleTick(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pet.Decay(1)
+	if b := s.pickBehaviorLocked(); b != nil {
+		// Act errors aren't fatal to the scheduler: a single behavior
+		// misbehaving shouldn't stop every other tick from running.
+		_ = b.Act(ctx, s.pet)
+	}
+}
+
+// pickBehaviorLocked chooses one of s.behaviors at random, weighted by
+// each behavior's configured weight. Callers must hold s.mu.
+func (s *Scheduler) pickBehaviorLocked() behavior.Behavior {
+	if len(s.behaviors) == 0 {
+		return nil
+	}
+
+	total := 0.0
+	for _, wb := range s.behaviors {
+		total += wb.weight
+	}
+	if total <= 0 {
+		return nil
+	}
+
+	r := rand.Float64() * total
+	for _, wb := range s.behaviors {
+		r -= wb.weight
+		if r <= 0 {
+			return wb.behave
+		}
+	}
+	return s.behaviors[len(s.behaviors)-1].behave
+}
+
+// LoadBehaviors builds the behaviors named in def.Behaviors (as set by a
+// pet's YAML manifest, via behavior.New) and replaces whatever set of
+// behaviors Run was previously picking from. It can be called before Run
+// starts or while it's already running — for example from a
+// config.WatchPet callback, so editing a pet's manifest changes which
+// behaviors run without restarting the app.
+func (s *Scheduler) LoadBehaviors(def *pet.Definition) error {
+	loaded := make([]weightedBehavior, 0, len(def.Behaviors))
+	for name, bc := range def.Behaviors {
+		if bc.Weight <= 0 {
+			continue
+		}
+		b, err := behavior.New(name, bc.Cfg)
+		if err != nil {
+			return fmt.Errorf("scheduler: load behavior %q: %w", name, err)
+		}
+		loaded = append(loaded, weightedBehavior{name: name, weight: bc.Weight, behave: b})
+	}
+
+	s.mu.Lock()
+	s.behaviors = loaded
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *Scheduler) applyState(state pet.State) {
+	s.mu.Lock()
+	s.pet.SetState(state)
+	s.mu.Unlock()
+}
+
+// Snapshot returns a consistent copy of the pet's current state. Callers
+// outside the scheduler (cli, tests) must use this instead of reading
+// the *pet.Pet directly, since Run mutates it from its own goroutine
+// under s.mu.
+func (s *Scheduler) Snapshot() Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.snapshotLocked()
+}
+
+// Feed raises the pet's mood by amount under lock and returns the
+// resulting snapshot; it's the synchronized equivalent of calling
+// Pet.Feed directly.
+func (s *Scheduler) Feed(amount pet.Mood) Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pet.Feed(amount)
+	return s.snapshotLocked()
+}
+
+func (s *Scheduler) snapshotLocked() Snapshot {
+	return Snapshot{
+		Species: s.pet.Species,
+		Pos:     s.pet.Pos,
+		State:   s.pet.State(),
+		Mood:    s.pet.Mood(),
+	}
+}
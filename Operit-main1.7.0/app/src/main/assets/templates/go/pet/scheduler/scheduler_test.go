@@ -0,0 +1,170 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"neodeskpet/behavior"
+	"neodeskpet/pet"
+)
+
+func TestHandleClickUpdatesPetUnderLock(t *testing.T) {
+	p := pet.New("shiba", pet.Position{})
+	s := New(p, 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- s.Run(ctx) }()
+
+	s.Clicks <- ClickEvent{X: 42, Y: 7}
+
+	waitFor(t, func() bool { return s.Snapshot().State == pet.StateDragging })
+	if pos := s.Snapshot().Pos; pos != (pet.Position{X: 42, Y: 7}) {
+		t.Errorf("Pos = %+v, want {42 7}", pos)
+	}
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Run() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestHandleTickDecaysMood(t *testing.T) {
+	p := pet.New("shiba", pet.Position{})
+	s := New(p, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go s.Run(ctx)
+
+	before := s.Snapshot().Mood
+	s.Ticks <- TickEvent{}
+	waitFor(t, func() bool { return s.Snapshot().Mood < before })
+}
+
+func TestFeedIsSynchronizedWithRun(t *testing.T) {
+	p := pet.New("shiba", pet.Position{})
+	s := New(p, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Run(ctx)
+
+	// Feed concurrently with the scheduler's own tick-driven decay; under
+	// -race this only passes if both go through s.mu instead of racing
+	// on the pet's fields directly.
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.Feed(1)
+		}()
+		s.Ticks <- TickEvent{}
+	}
+	wg.Wait()
+
+	if mood := s.Snapshot().Mood; mood < pet.MoodMin || mood > pet.MoodMax {
+		t.Errorf("Mood = %d, want it within [%d, %d]", mood, pet.MoodMin, pet.MoodMax)
+	}
+}
+
+func TestLoadBehaviorsRunsConfiguredBehaviorOnTick(t *testing.T) {
+	p := pet.New("shiba", pet.Position{})
+	s := New(p, 1)
+
+	def := &pet.Definition{Behaviors: map[string]pet.BehaviorConfig{
+		"wander": {Weight: 1, Cfg: map[string]any{"step_pixels": 3}},
+	}}
+	if err := s.LoadBehaviors(def); err != nil {
+		t.Fatalf("LoadBehaviors() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Run(ctx)
+
+	s.Ticks <- TickEvent{}
+	waitFor(t, func() bool { return s.Snapshot().State == pet.StateWalking })
+}
+
+func TestLoadBehaviorsPassesCfgToBehaviorFactory(t *testing.T) {
+	p := pet.New("shiba", pet.Position{})
+	s := New(p, 1)
+
+	def := &pet.Definition{Behaviors: map[string]pet.BehaviorConfig{
+		"wander": {Weight: 1, Cfg: map[string]any{"step_pixels": 3}},
+	}}
+	if err := s.LoadBehaviors(def); err != nil {
+		t.Fatalf("LoadBehaviors() error = %v", err)
+	}
+
+	if len(s.behaviors) != 1 {
+		t.Fatalf("len(s.behaviors) = %d, want 1", len(s.behaviors))
+	}
+	wander, ok := s.behaviors[0].behave.(*behavior.WanderBehavior)
+	if !ok {
+		t.Fatalf("behaviors[0].behave = %T, want *behavior.WanderBehavior", s.behaviors[0].behave)
+	}
+	if wander.StepPixels != 3 {
+		t.Errorf("StepPixels = %d, want the manifest's cfg.step_pixels (3), not the built-in default", wander.StepPixels)
+	}
+}
+
+func TestLoadBehaviorsRejectsUnknownBehavior(t *testing.T) {
+	p := pet.New("shiba", pet.Position{})
+	s := New(p, 1)
+
+	def := &pet.Definition{Behaviors: map[string]pet.BehaviorConfig{
+		"does_not_exist": {Weight: 1},
+	}}
+	if err := s.LoadBehaviors(def); err == nil {
+		t.Fatal("LoadBehaviors() error = nil, want error for unregistered behavior")
+	}
+}
+
+func TestQueueEffectBoundedDoesNotLeak(t *testing.T) {
+	p := pet.New("shiba", pet.Position{})
+	s := New(p, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Run(ctx)
+
+	var wg sync.WaitGroup
+	for i := 0; i < defaultEffectQueue*2; i++ {
+		wg.Add(1)
+		if !s.QueueEffect(func() { defer wg.Done() }) {
+			wg.Done() // queue was full; this effect was dropped, not leaked
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("effects never drained; worker pool may have deadlocked")
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("condition not met before deadline")
+}
@@ -0,0 +1,11 @@
+// Package native provides CGo-backed render.Surface implementations that
+// host the pet sprite in a borderless, always-on-top, click-through-
+// capable OS window with an alpha channel. The platform-specific backend
+// (X11 through Xlib on Linux, Win32 on Windows, Cocoa on macOS) is
+// selected at build time via build tags; there is no Wayland backend.
+// Callers only ever see the render.Surface interface returned by New.
+package native
+
+// DefaultTitle is used for the native window when callers don't care to
+// pick their own.
+const DefaultTitle = "NeoDeskPet"
@@ -0,0 +1,136 @@
+//go:build darwin
+
+package native
+
+/*
+#cgo LDFLAGS: -framework Cocoa
+#include <stdlib.h>
+#include "native_darwin.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"image"
+	"image/draw"
+	"sync"
+	"unsafe"
+
+	"neodeskpet/render"
+)
+
+// surface is the Cocoa backend for render.Surface. It hosts the pet in
+// an NSWindow with NSWindowStyleMaskBorderless, setOpaque:NO and a
+// floating window level for always-on-top behavior; click-through is
+// toggled via NSWindow.ignoresMouseEvents.
+type surface struct {
+	mu      sync.Mutex
+	win     unsafe.Pointer
+	onMouse func(render.MouseEvent)
+}
+
+var surfacesByWindow sync.Map // unsafe.Pointer -> *surface
+
+// New creates a transparent, always-on-top NSWindow of the given size to
+// host the pet sprite.
+func New(width, height int, title string) (render.Surface, error) {
+	if title == "" {
+		title = DefaultTitle
+	}
+	ctitle := C.CString(title)
+	defer C.free(unsafe.Pointer(ctitle))
+
+	win := C.nd_create_window(C.int(width), C.int(height), ctitle)
+	if win == nil {
+		return nil, errors.New("native: failed to create NSWindow")
+	}
+
+	s := &surface{win: win}
+	surfacesByWindow.Store(win, s)
+	return s, nil
+}
+
+// DrawFrame uploads img as the window's layer contents, converting from
+// Go's RGBA byte order to the BGRA order NSBitmapImageRep expects.
+func (s *surface) DrawFrame(img image.Image) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b := img.Bounds()
+	rgba := image.NewRGBA(b)
+	draw.Draw(rgba, b, img, b.Min, draw.Src)
+	data := bgraFromRGBA(rgba)
+
+	C.nd_draw_frame(s.win, (*C.uchar)(unsafe.Pointer(&data[0])), C.int(b.Dx()), C.int(b.Dy()))
+	return nil
+}
+
+// bgraFromRGBA converts Go's RGBA byte order to the byte order
+// NSBitmapImageRep expects.
+func bgraFromRGBA(img *image.RGBA) []byte {
+	out := make([]byte, len(img.Pix))
+	for i := 0; i+3 < len(img.Pix); i += 4 {
+		r, g, b, a := img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3]
+		out[i+0] = b
+		out[i+1] = g
+		out[i+2] = r
+		out[i+3] = a
+	}
+	return out
+}
+
+// SetHitRegion toggles ignoresMouseEvents for the whole window. A future
+// revision can swap the content view for one with a precise mouse mask.
+func (s *surface) SetHitRegion(mask image.Alpha) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	opaque := false
+	b := mask.Bounds()
+	for y := b.Min.Y; y < b.Max.Y && !opaque; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if mask.AlphaAt(x, y).A > 0 {
+				opaque = true
+				break
+			}
+		}
+	}
+
+	clickThrough := C.int(0)
+	if !opaque {
+		clickThrough = 1
+	}
+	C.nd_set_click_through(s.win, clickThrough)
+	return nil
+}
+
+// OnMouseEvent registers fn to be called for every mouse event the
+// window's content view receives.
+func (s *surface) OnMouseEvent(fn func(render.MouseEvent)) {
+	s.mu.Lock()
+	s.onMouse = fn
+	s.mu.Unlock()
+}
+
+// Close releases the NSWindow.
+func (s *surface) Close() error {
+	surfacesByWindow.Delete(s.win)
+	C.nd_close_window(s.win)
+	return nil
+}
+
+//export nd_mouse_event_go
+func nd_mouse_event_go(win unsafe.Pointer, x, y C.int, kind C.int) {
+	v, ok := surfacesByWindow.Load(win)
+	if !ok {
+		return
+	}
+	s := v.(*surface)
+
+	s.mu.Lock()
+	onMouse := s.onMouse
+	s.mu.Unlock()
+	if onMouse != nil {
+		onMouse(render.MouseEvent{X: int(x), Y: int(y), Kind: render.MouseEventKind(kind)})
+	}
+}
@@ -0,0 +1,257 @@
+//go:build linux
+
+package native
+
+/*
+#cgo pkg-config: x11 xext
+#include <X11/Xlib.h>
+#include <X11/Xutil.h>
+#include <X11/extensions/shape.h>
+#include <X11/Xatom.h>
+#include <stdlib.h>
+
+static void nd_set_always_on_top(Display *dpy, Window win) {
+	Atom state = XInternAtom(dpy, "_NET_WM_STATE", False);
+	Atom above = XInternAtom(dpy, "_NET_WM_STATE_ABOVE", False);
+	XChangeProperty(dpy, win, state, XA_ATOM, 32, PropModeReplace, (unsigned char *)&above, 1);
+}
+
+static XImage *nd_create_ximage(Display *dpy, Visual *visual, int depth, int width, int height, char *data) {
+	return XCreateImage(dpy, visual, depth, ZPixmap, 0, data, (unsigned)width, (unsigned)height, 32, 0);
+}
+
+static int nd_event_type(XEvent *ev) {
+	return ev->type;
+}
+
+static void nd_destroy_image(XImage *img) {
+	XDestroyImage(img);
+}
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"runtime"
+	"sync"
+	"time"
+	"unsafe"
+
+	"neodeskpet/render"
+)
+
+// surface is the X11/Xlib backend for render.Surface. It creates a
+// borderless, always-on-top, 32-bit ARGB window via XCreateWindow with a
+// TrueColor visual, uses the XShape extension to make regions outside
+// the pet's hit mask click-through, and forwards button/motion events to
+// the registered callback.
+type surface struct {
+	mu      sync.Mutex
+	dpy     *C.Display
+	win     C.Window
+	visual  *C.Visual
+	depth   C.int
+	onMouse func(render.MouseEvent)
+	done    chan struct{}
+}
+
+// New opens a connection to the X server and creates a click-through-
+// capable, always-on-top window of the given size to host the pet
+// sprite.
+func New(width, height int, title string) (render.Surface, error) {
+	// Xlib only guarantees thread safety between calls on different
+	// threads if XInitThreads is called before the first XOpenDisplay;
+	// DrawFrame and SetHitRegion issue Xlib calls from whichever
+	// goroutine the scheduler/render loop happens to run on, concurrently
+	// with eventLoop's XPending/XNextEvent, so this must run first.
+	if C.XInitThreads() == 0 {
+		return nil, errors.New("native: XInitThreads failed")
+	}
+
+	dpy := C.XOpenDisplay(nil)
+	if dpy == nil {
+		return nil, errors.New("native: cannot open X display")
+	}
+
+	screen := C.XDefaultScreen(dpy)
+	root := C.XRootWindow(dpy, screen)
+
+	var vinfo C.XVisualInfo
+	if C.XMatchVisualInfo(dpy, screen, 32, C.TrueColor, &vinfo) == 0 {
+		C.XCloseDisplay(dpy)
+		return nil, errors.New("native: no 32-bit TrueColor visual available")
+	}
+
+	cmap := C.XCreateColormap(dpy, root, vinfo.visual, C.AllocNone)
+
+	var attrs C.XSetWindowAttributes
+	attrs.colormap = cmap
+	attrs.border_pixel = 0
+	attrs.background_pixel = 0
+	attrs.override_redirect = C.True
+	attrs.event_mask = C.ButtonPressMask | C.ButtonReleaseMask | C.PointerMotionMask
+
+	mask := C.CWColormap | C.CWBorderPixel | C.CWBackPixel | C.CWOverrideRedirect | C.CWEventMask
+
+	win := C.XCreateWindow(dpy, root, 0, 0, C.uint(width), C.uint(height), 0,
+		vinfo.depth, C.InputOutput, vinfo.visual, C.ulong(mask), &attrs)
+
+	if title == "" {
+		title = DefaultTitle
+	}
+	ctitle := C.CString(title)
+	defer C.free(unsafe.Pointer(ctitle))
+	C.XStoreName(dpy, win, ctitle)
+
+	C.nd_set_always_on_top(dpy, win)
+	C.XMapWindow(dpy, win)
+	C.XFlush(dpy)
+
+	s := &surface{
+		dpy:    dpy,
+		win:    win,
+		visual: vinfo.visual,
+		depth:  vinfo.depth,
+		done:   make(chan struct{}),
+	}
+	go s.eventLoop()
+	return s, nil
+}
+
+// DrawFrame uploads img into the window via XPutImage, converting from
+// Go's RGBA byte order to the BGRA order the X server expects.
+func (s *surface) DrawFrame(img image.Image) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b := img.Bounds()
+	rgba := image.NewRGBA(b)
+	draw.Draw(rgba, b, img, b.Min, draw.Src)
+	data := bgraFromRGBA(rgba)
+
+	ximg := C.nd_create_ximage(s.dpy, s.visual, s.depth, C.int(b.Dx()), C.int(b.Dy()),
+		(*C.char)(unsafe.Pointer(&data[0])))
+	if ximg == nil {
+		return fmt.Errorf("native: XCreateImage failed")
+	}
+	defer C.nd_destroy_image(ximg)
+
+	gc := C.XCreateGC(s.dpy, C.Drawable(s.win), 0, nil)
+	defer C.XFreeGC(s.dpy, gc)
+
+	C.XPutImage(s.dpy, C.Drawable(s.win), gc, ximg, 0, 0, 0, 0, C.uint(b.Dx()), C.uint(b.Dy()))
+	C.XFlush(s.dpy)
+	return nil
+}
+
+// bgraFromRGBA converts Go's RGBA byte order to the byte order a 32-bit
+// TrueColor X visual expects on little-endian hosts.
+func bgraFromRGBA(img *image.RGBA) []byte {
+	out := make([]byte, len(img.Pix))
+	for i := 0; i+3 < len(img.Pix); i += 4 {
+		r, g, b, a := img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3]
+		out[i+0] = b
+		out[i+1] = g
+		out[i+2] = r
+		out[i+3] = a
+	}
+	return out
+}
+
+// SetHitRegion uses the XShape extension to restrict mouse input to
+// pixels where mask is non-transparent; everything else becomes
+// click-through.
+func (s *surface) SetHitRegion(mask image.Alpha) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b := mask.Bounds()
+	bitmap := C.XCreatePixmap(s.dpy, C.Drawable(s.win), C.uint(b.Dx()), C.uint(b.Dy()), 1)
+	gc := C.XCreateGC(s.dpy, C.Drawable(bitmap), 0, nil)
+	defer C.XFreeGC(s.dpy, gc)
+	defer C.XFreePixmap(s.dpy, bitmap)
+
+	for y := 0; y < b.Dy(); y++ {
+		for x := 0; x < b.Dx(); x++ {
+			if mask.AlphaAt(b.Min.X+x, b.Min.Y+y).A > 0 {
+				C.XDrawPoint(s.dpy, C.Drawable(bitmap), gc, C.int(x), C.int(y))
+			}
+		}
+	}
+
+	C.XShapeCombineMask(s.dpy, s.win, C.ShapeInput, 0, 0, bitmap, C.ShapeSet)
+	C.XFlush(s.dpy)
+	return nil
+}
+
+// OnMouseEvent registers fn to be called for every button/motion event
+// the window receives.
+func (s *surface) OnMouseEvent(fn func(render.MouseEvent)) {
+	s.mu.Lock()
+	s.onMouse = fn
+	s.mu.Unlock()
+}
+
+// Close tears down the event loop, the window, and the X connection.
+func (s *surface) Close() error {
+	close(s.done)
+	C.XDestroyWindow(s.dpy, s.win)
+	C.XCloseDisplay(s.dpy)
+	return nil
+}
+
+// eventLoop is the one goroutine that ever calls XPending/XNextEvent; it
+// pins itself to its OS thread for the lifetime of the surface, since
+// Xlib's per-thread state (set up by XInitThreads in New) assumes each
+// thread that touches the display keeps using the same underlying OS
+// thread.
+func (s *surface) eventLoop() {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	var ev C.XEvent
+	for {
+		select {
+		case <-s.done:
+			return
+		default:
+		}
+
+		if C.XPending(s.dpy) == 0 {
+			time.Sleep(time.Millisecond)
+			continue
+		}
+		C.XNextEvent(s.dpy, &ev)
+
+		me, ok := translateEvent(&ev)
+		if !ok {
+			continue
+		}
+
+		s.mu.Lock()
+		onMouse := s.onMouse
+		s.mu.Unlock()
+		if onMouse != nil {
+			onMouse(me)
+		}
+	}
+}
+
+func translateEvent(ev *C.XEvent) (render.MouseEvent, bool) {
+	switch C.nd_event_type(ev) {
+	case C.ButtonPress:
+		b := (*C.XButtonEvent)(unsafe.Pointer(ev))
+		return render.MouseEvent{X: int(b.x), Y: int(b.y), Kind: render.MouseDown}, true
+	case C.ButtonRelease:
+		b := (*C.XButtonEvent)(unsafe.Pointer(ev))
+		return render.MouseEvent{X: int(b.x), Y: int(b.y), Kind: render.MouseUp}, true
+	case C.MotionNotify:
+		m := (*C.XMotionEvent)(unsafe.Pointer(ev))
+		return render.MouseEvent{X: int(m.x), Y: int(m.y), Kind: render.MouseMove}, true
+	default:
+		return render.MouseEvent{}, false
+	}
+}
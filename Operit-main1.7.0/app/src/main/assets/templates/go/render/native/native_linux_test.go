@@ -0,0 +1,16 @@
+//go:build linux
+
+package native
+
+import "testing"
+
+// TestNewRequiresDisplay opens and closes a window when an X server is
+// reachable. When New returns an error instead, as on most headless CI
+// runners, the test is skipped.
+func TestNewRequiresDisplay(t *testing.T) {
+	s, err := New(64, 64, "test")
+	if err != nil {
+		t.Skipf("no X display available: %v", err)
+	}
+	defer s.Close()
+}
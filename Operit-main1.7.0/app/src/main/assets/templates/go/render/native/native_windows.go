@@ -0,0 +1,234 @@
+//go:build windows
+
+package native
+
+/*
+#cgo LDFLAGS: -luser32 -lgdi32
+#include <windows.h>
+
+extern LRESULT nd_wndproc_go(HWND hwnd, UINT msg, WPARAM wparam, LPARAM lparam);
+
+static LRESULT CALLBACK nd_wndproc(HWND hwnd, UINT msg, WPARAM wparam, LPARAM lparam) {
+	return nd_wndproc_go(hwnd, msg, wparam, lparam);
+}
+
+static HWND nd_create_window(int width, int height, const char *title) {
+	WNDCLASSA wc = {0};
+	wc.lpfnWndProc = nd_wndproc;
+	wc.hInstance = GetModuleHandleA(NULL);
+	wc.lpszClassName = "NeoDeskPetWindow";
+	RegisterClassA(&wc);
+
+	HWND hwnd = CreateWindowExA(
+		WS_EX_LAYERED | WS_EX_TRANSPARENT | WS_EX_TOPMOST | WS_EX_TOOLWINDOW,
+		wc.lpszClassName, title, WS_POPUP,
+		CW_USEDEFAULT, CW_USEDEFAULT, width, height,
+		NULL, NULL, wc.hInstance, NULL);
+	if (hwnd != NULL) {
+		ShowWindow(hwnd, SW_SHOW);
+	}
+	return hwnd;
+}
+
+// nd_draw_frame uploads a premultiplied BGRA buffer as the window's
+// contents via UpdateLayeredWindow. It builds a top-down 32bpp DIB
+// section sized to the frame, blits bgra into it, and hands it to
+// UpdateLayeredWindow with AC_SRC_ALPHA so Windows composites per-pixel
+// alpha instead of the constant alpha SetLayeredWindowAttributes would
+// give; calling both on the same window isn't supported, which is why
+// nd_create_window no longer calls SetLayeredWindowAttributes.
+static int nd_draw_frame(HWND hwnd, unsigned char *bgra, int width, int height) {
+	HDC screenDC = GetDC(NULL);
+	HDC memDC = CreateCompatibleDC(screenDC);
+
+	BITMAPINFO bmi = {0};
+	bmi.bmiHeader.biSize = sizeof(BITMAPINFOHEADER);
+	bmi.bmiHeader.biWidth = width;
+	bmi.bmiHeader.biHeight = -height;
+	bmi.bmiHeader.biPlanes = 1;
+	bmi.bmiHeader.biBitCount = 32;
+	bmi.bmiHeader.biCompression = BI_RGB;
+
+	void *bits = NULL;
+	HBITMAP bitmap = CreateDIBSection(screenDC, &bmi, DIB_RGB_COLORS, &bits, NULL, 0);
+	ReleaseDC(NULL, screenDC);
+	if (bitmap == NULL || bits == NULL) {
+		DeleteDC(memDC);
+		return 0;
+	}
+	memcpy(bits, bgra, (size_t)width * (size_t)height * 4);
+
+	HBITMAP oldBitmap = (HBITMAP)SelectObject(memDC, bitmap);
+
+	SIZE size = {width, height};
+	POINT srcPoint = {0, 0};
+	BLENDFUNCTION blend = {0};
+	blend.BlendOp = AC_SRC_OVER;
+	blend.SourceConstantAlpha = 255;
+	blend.AlphaFormat = AC_SRC_ALPHA;
+
+	BOOL ok = UpdateLayeredWindow(hwnd, NULL, NULL, &size, memDC, &srcPoint, 0, &blend, ULW_ALPHA);
+
+	SelectObject(memDC, oldBitmap);
+	DeleteObject(bitmap);
+	DeleteDC(memDC);
+	return ok ? 1 : 0;
+}
+
+static void nd_set_click_through(HWND hwnd, int clickThrough) {
+	LONG_PTR style = GetWindowLongPtrA(hwnd, GWL_EXSTYLE);
+	if (clickThrough) {
+		style |= WS_EX_TRANSPARENT;
+	} else {
+		style &= ~WS_EX_TRANSPARENT;
+	}
+	SetWindowLongPtrA(hwnd, GWL_EXSTYLE, style);
+}
+*/
+import "C"
+
+import (
+	"errors"
+	"image"
+	"image/draw"
+	"sync"
+	"unsafe"
+
+	"neodeskpet/render"
+)
+
+// surface is the Win32 backend for render.Surface. It creates a
+// layered (WS_EX_LAYERED), always-on-top (WS_EX_TOPMOST), borderless
+// (WS_POPUP) window, draws frames with UpdateLayeredWindow for per-pixel
+// alpha, and toggles WS_EX_TRANSPARENT for click-through.
+type surface struct {
+	mu      sync.Mutex
+	hwnd    C.HWND
+	onMouse func(render.MouseEvent)
+}
+
+var surfacesByHWND sync.Map // C.HWND -> *surface
+
+// New creates a transparent, always-on-top Win32 window of the given
+// size to host the pet sprite.
+func New(width, height int, title string) (render.Surface, error) {
+	if title == "" {
+		title = DefaultTitle
+	}
+	ctitle := C.CString(title)
+	defer C.free(unsafe.Pointer(ctitle))
+
+	hwnd := C.nd_create_window(C.int(width), C.int(height), ctitle)
+	if hwnd == nil {
+		return nil, errors.New("native: CreateWindowExA failed")
+	}
+
+	s := &surface{hwnd: hwnd}
+	surfacesByHWND.Store(hwnd, s)
+	return s, nil
+}
+
+// DrawFrame uploads img to the window via UpdateLayeredWindow, converting
+// from Go's RGBA byte order to the premultiplied BGRA order
+// UpdateLayeredWindow's AC_SRC_ALPHA blend expects.
+func (s *surface) DrawFrame(img image.Image) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b := img.Bounds()
+	rgba := image.NewRGBA(b)
+	draw.Draw(rgba, b, img, b.Min, draw.Src)
+	data := premultipliedBGRAFromRGBA(rgba)
+
+	if C.nd_draw_frame(s.hwnd, (*C.uchar)(unsafe.Pointer(&data[0])), C.int(b.Dx()), C.int(b.Dy())) == 0 {
+		return errors.New("native: UpdateLayeredWindow failed")
+	}
+	return nil
+}
+
+// premultipliedBGRAFromRGBA converts Go's RGBA byte order to the
+// premultiplied BGRA order UpdateLayeredWindow requires when its blend
+// function's AlphaFormat is AC_SRC_ALPHA.
+func premultipliedBGRAFromRGBA(img *image.RGBA) []byte {
+	out := make([]byte, len(img.Pix))
+	for i := 0; i+3 < len(img.Pix); i += 4 {
+		r, g, b, a := img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3]
+		out[i+0] = byte(uint16(b) * uint16(a) / 255)
+		out[i+1] = byte(uint16(g) * uint16(a) / 255)
+		out[i+2] = byte(uint16(r) * uint16(a) / 255)
+		out[i+3] = a
+	}
+	return out
+}
+
+// SetHitRegion toggles WS_EX_TRANSPARENT for the whole window. A future
+// revision can use SetWindowRgn for sub-window precision.
+func (s *surface) SetHitRegion(mask image.Alpha) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	opaque := false
+	b := mask.Bounds()
+	for y := b.Min.Y; y < b.Max.Y && !opaque; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if mask.AlphaAt(x, y).A > 0 {
+				opaque = true
+				break
+			}
+		}
+	}
+
+	clickThrough := 0
+	if !opaque {
+		clickThrough = 1
+	}
+	C.nd_set_click_through(s.hwnd, C.int(clickThrough))
+	return nil
+}
+
+// OnMouseEvent registers fn to be called for every mouse message the
+// window procedure receives.
+func (s *surface) OnMouseEvent(fn func(render.MouseEvent)) {
+	s.mu.Lock()
+	s.onMouse = fn
+	s.mu.Unlock()
+}
+
+// Close destroys the window.
+func (s *surface) Close() error {
+	surfacesByHWND.Delete(s.hwnd)
+	C.DestroyWindow(s.hwnd)
+	return nil
+}
+
+//export nd_wndproc_go
+func nd_wndproc_go(hwnd C.HWND, msg C.UINT, wparam C.WPARAM, lparam C.LPARAM) C.LRESULT {
+	v, ok := surfacesByHWND.Load(hwnd)
+	if !ok {
+		return C.DefWindowProcA(hwnd, msg, wparam, lparam)
+	}
+	s := v.(*surface)
+
+	x := int(C.short(lparam & 0xffff))
+	y := int(C.short((lparam >> 16) & 0xffff))
+
+	var kind render.MouseEventKind
+	switch msg {
+	case C.WM_LBUTTONDOWN:
+		kind = render.MouseDown
+	case C.WM_LBUTTONUP:
+		kind = render.MouseUp
+	case C.WM_MOUSEMOVE:
+		kind = render.MouseMove
+	default:
+		return C.DefWindowProcA(hwnd, msg, wparam, lparam)
+	}
+
+	s.mu.Lock()
+	onMouse := s.onMouse
+	s.mu.Unlock()
+	if onMouse != nil {
+		onMouse(render.MouseEvent{X: x, Y: y, Kind: kind})
+	}
+	return 0
+}
@@ -0,0 +1,72 @@
+// Package render defines the window/canvas abstraction that the pet is
+// drawn onto. Concrete backends (the native always-on-top windows in
+// render/native, and NullSurface below for headless runs) implement
+// Surface.
+package render
+
+import "image"
+
+// MouseEvent describes a pointer interaction with a Surface, in the
+// surface's local pixel coordinates.
+type MouseEvent struct {
+	X, Y int
+	Kind MouseEventKind
+}
+
+// MouseEventKind identifies what kind of mouse interaction occurred.
+type MouseEventKind int
+
+const (
+	MouseMove MouseEventKind = iota
+	MouseDown
+	MouseUp
+)
+
+// Surface is a drawable target that hosts the pet sprite. Implementations
+// are free to be a real OS window, an offscreen buffer, or a no-op stub
+// used in tests.
+type Surface interface {
+	// DrawFrame renders img as the current frame.
+	DrawFrame(img image.Image) error
+
+	// SetHitRegion restricts which pixels of the surface accept mouse
+	// input; pixels outside mask should be click-through where the
+	// backend supports it.
+	SetHitRegion(mask image.Alpha) error
+
+	// OnMouseEvent registers a callback invoked for every mouse event
+	// the surface observes. Only one callback is kept; registering again
+	// replaces the previous one.
+	OnMouseEvent(func(MouseEvent))
+
+	// Close releases any resources held by the surface.
+	Close() error
+}
+
+// NullSurface is a Surface that discards frames and reports a mouse event
+// only when Emit is called. It's useful for headless runs (CLI-only mode,
+// CI, tests).
+type NullSurface struct {
+	onMouse func(MouseEvent)
+}
+
+// NewNullSurface returns a ready-to-use NullSurface.
+func NewNullSurface() *NullSurface {
+	return &NullSurface{}
+}
+
+func (s *NullSurface) DrawFrame(img image.Image) error { return nil }
+
+func (s *NullSurface) SetHitRegion(mask image.Alpha) error { return nil }
+
+func (s *NullSurface) OnMouseEvent(fn func(MouseEvent)) { s.onMouse = fn }
+
+func (s *NullSurface) Close() error { return nil }
+
+// Emit feeds an event to the registered callback, if any. Test code uses
+// this to drive OnMouseEvent subscribers.
+func (s *NullSurface) Emit(ev MouseEvent) {
+	if s.onMouse != nil {
+		s.onMouse(ev)
+	}
+}
@@ -0,0 +1,41 @@
+package render
+
+import (
+	"image"
+	"testing"
+)
+
+func TestNullSurfaceDrawFrameNoop(t *testing.T) {
+	s := NewNullSurface()
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+
+	if err := s.DrawFrame(img); err != nil {
+		t.Fatalf("DrawFrame() error = %v", err)
+	}
+	if err := s.SetHitRegion(*image.NewAlpha(image.Rect(0, 0, 4, 4))); err != nil {
+		t.Fatalf("SetHitRegion() error = %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+}
+
+func TestNullSurfaceMouseEvent(t *testing.T) {
+	s := NewNullSurface()
+
+	var got MouseEvent
+	called := false
+	s.OnMouseEvent(func(ev MouseEvent) {
+		called = true
+		got = ev
+	})
+
+	s.Emit(MouseEvent{X: 1, Y: 2, Kind: MouseDown})
+
+	if !called {
+		t.Fatal("OnMouseEvent callback was not invoked")
+	}
+	if got != (MouseEvent{X: 1, Y: 2, Kind: MouseDown}) {
+		t.Errorf("got %+v, want {1 2 MouseDown}", got)
+	}
+}